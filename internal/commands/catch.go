@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/irukandji81/pokedex/internal/pokeapi"
+	"github.com/irukandji81/pokedex/internal/pokeclient"
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// CatchFunc returns the "catch" command, which attempts to catch a
+// Pokémon found in the trainer's current location area.
+func CatchFunc(client *pokeclient.Client, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("you must specify a Pokémon to catch")
+		}
+		if trainer.Current == "" {
+			return fmt.Errorf("you must visit a location area first (use 'visit <area>')")
+		}
+
+		pokemonName := strings.ToLower(args[0])
+		if trainer.HasCaught(pokemonName) {
+			return fmt.Errorf("%s is already in your Pokedex", pokemonName)
+		}
+
+		pokemon, err := client.GetPokemon(pokemonName)
+		if err != nil {
+			return err
+		}
+
+		found, err := appearsIn(client, pokemon, trainer.Current)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("%s doesn't appear in %s", pokemonName, trainer.Current)
+		}
+
+		fmt.Printf("Throwing a Pokeball at %s...\n", pokemonName)
+		return determineCatchResult(trainer, pokemon)
+	}
+}
+
+// appearsIn reports whether pokemon can be encountered in the given
+// location area.
+func appearsIn(client *pokeclient.Client, pokemon pokeapi.Pokemon, area string) (bool, error) {
+	encounters, err := client.GetPokemonLocationAreas(pokemon.LocationAreaEncounters)
+	if err != nil {
+		return false, err
+	}
+
+	for _, encounter := range encounters {
+		if encounter.LocationArea.Name == area {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func determineCatchResult(trainer *poketrainer.Trainer, pokemon pokeapi.Pokemon) error {
+	chance := 100.0 / float64(pokemon.BaseExperience)
+	caught := rand.Float64() < chance
+
+	if caught {
+		stats := make(map[string]int)
+		for _, stat := range pokemon.Stats {
+			stats[stat.Stat.Name] = stat.BaseStat
+		}
+
+		types := []string{}
+		for _, t := range pokemon.Types {
+			types = append(types, t.Type.Name)
+		}
+
+		trainer.Catch(poketrainer.PokemonData{
+			Name:   pokemon.Name,
+			Height: pokemon.Height,
+			Weight: pokemon.Weight,
+			Stats:  stats,
+			Types:  types,
+		})
+
+		fmt.Printf("%s was caught!\n", pokemon.Name)
+	} else {
+		fmt.Printf("%s escaped!\n", pokemon.Name)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package commands holds the REPL's commands, one file per command. Each
+// file exports a factory (e.g. CatchFunc) that closes over the
+// dependencies that command needs and returns a CommandFunc ready to
+// register. main wires the dependencies and registers the result; it
+// doesn't know how any individual command works.
+package commands
+
+// CommandFunc is the callback a REPL command executes with its
+// whitespace-separated arguments.
+type CommandFunc func(args []string) error
+
+// Command pairs a CommandFunc with the metadata the REPL needs to
+// register, describe, and complete it.
+type Command struct {
+	Name        string
+	Description string
+	Run         CommandFunc
+}
@@ -0,0 +1,12 @@
+package commands
+
+import "fmt"
+
+// ExitFunc returns the "exit" command. Its error is a sentinel the REPL
+// loop recognizes to break out cleanly rather than reporting a failure.
+func ExitFunc() CommandFunc {
+	return func(args []string) error {
+		fmt.Println("Closing the Pokedex... Goodbye!")
+		return fmt.Errorf("exit")
+	}
+}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/irukandji81/pokedex/internal/pokeclient"
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// ExploreFunc returns the "explore" command, which lists the Pokémon
+// found in the trainer's current location area.
+func ExploreFunc(client *pokeclient.Client, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if trainer.Current == "" {
+			return fmt.Errorf("you must visit a location area first (use 'visit <area>')")
+		}
+
+		area, err := client.GetLocationArea(trainer.Current)
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, 0, len(area.PokemonEncounters))
+		for _, encounter := range area.PokemonEncounters {
+			names = append(names, encounter.Pokemon.Name)
+		}
+		trainer.RememberPokemon(names)
+
+		if len(names) == 0 {
+			fmt.Println("No Pokémon found in this area!")
+			return nil
+		}
+
+		fmt.Println("Found Pokémon:")
+		for _, name := range names {
+			fmt.Printf(" - %s\n", name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,16 @@
+package commands
+
+import "fmt"
+
+// HelpFunc returns the "help" command. It closes over the registry it's
+// part of so it can list every registered command, itself included.
+func HelpFunc(registry map[string]Command) CommandFunc {
+	return func(args []string) error {
+		fmt.Println("Welcome to the Pokedex!")
+		fmt.Println("Usage:")
+		for _, cmd := range registry {
+			fmt.Printf("%s: %s\n", cmd.Name, cmd.Description)
+		}
+		return nil
+	}
+}
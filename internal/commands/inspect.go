@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// InspectFunc returns the "inspect" command, which prints the details of
+// a caught Pokémon.
+func InspectFunc(trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("you must specify a Pokémon to inspect")
+		}
+
+		pokemonName := strings.ToLower(args[0])
+		data, found := trainer.Pokedex[pokemonName]
+		if !found {
+			fmt.Println("you have not caught that Pokémon")
+			return nil
+		}
+
+		fmt.Printf("Name: %s\n", data.Name)
+		fmt.Printf("Height: %d\n", data.Height)
+		fmt.Printf("Weight: %d\n", data.Weight)
+		fmt.Println("Stats:")
+		for stat, value := range data.Stats {
+			fmt.Printf("  -%s: %d\n", stat, value)
+		}
+		fmt.Println("Types:")
+		for _, t := range data.Types {
+			fmt.Printf("  - %s\n", t)
+		}
+		return nil
+	}
+}
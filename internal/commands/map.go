@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/irukandji81/pokedex/internal/pokeclient"
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// MapFunc returns the "map" command, which lists the next page of
+// location areas.
+func MapFunc(client *pokeclient.Client, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		url := ""
+		if trainer.Next != nil {
+			url = *trainer.Next
+		}
+
+		list, err := client.GetNamedAPIResourceList(url)
+		if err != nil {
+			return err
+		}
+
+		for _, location := range list.Results {
+			fmt.Println(location.Name)
+			trainer.RememberArea(location.Name)
+		}
+
+		trainer.Next = list.Next
+		trainer.Previous = list.Previous
+		return nil
+	}
+}
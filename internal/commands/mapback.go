@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/irukandji81/pokedex/internal/pokeclient"
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// MapBackFunc returns the "mapb" command, which lists the previous page
+// of location areas.
+func MapBackFunc(client *pokeclient.Client, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if trainer.Previous == nil {
+			fmt.Println("you're on the first page")
+			return nil
+		}
+
+		list, err := client.GetNamedAPIResourceList(*trainer.Previous)
+		if err != nil {
+			return err
+		}
+
+		for _, location := range list.Results {
+			fmt.Println(location.Name)
+			trainer.RememberArea(location.Name)
+		}
+
+		trainer.Next = list.Next
+		trainer.Previous = list.Previous
+		return nil
+	}
+}
@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// PokedexFunc returns the "pokedex" command, which lists every Pokémon
+// the trainer has caught.
+func PokedexFunc(trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(trainer.Pokedex) == 0 {
+			fmt.Println("Your Pokedex is empty. Go catch some Pokémon!")
+			return nil
+		}
+
+		fmt.Println("Your Pokedex:")
+		for pokemon := range trainer.Pokedex {
+			fmt.Printf(" - %s\n", pokemon)
+		}
+		return nil
+	}
+}
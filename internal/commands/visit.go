@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/irukandji81/pokedex/internal/pokeclient"
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// VisitFunc returns the "visit" command, which sets the trainer's
+// current location area.
+func VisitFunc(client *pokeclient.Client, trainer *poketrainer.Trainer) CommandFunc {
+	return func(args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("you must specify a location area to visit")
+		}
+
+		areaName := strings.ToLower(args[0])
+		area, err := client.GetLocationArea(areaName)
+		if err != nil {
+			return fmt.Errorf("couldn't find location area %q: %v", areaName, err)
+		}
+
+		trainer.Visit(area.Name)
+		trainer.RememberArea(area.Name)
+		fmt.Printf("You are now in %s\n", area.Name)
+		return nil
+	}
+}
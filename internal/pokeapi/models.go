@@ -0,0 +1,65 @@
+// Package pokeapi holds the typed JSON models returned by the PokeAPI
+// endpoints this project talks to. Keeping them here means the decoding
+// logic lives in exactly one place instead of being re-declared as
+// anonymous structs next to every http.Get call.
+package pokeapi
+
+// NamedAPIResource is the {name, url} pair PokeAPI uses to reference
+// another resource without inlining it.
+type NamedAPIResource struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// NamedAPIResourceList is the paginated envelope PokeAPI wraps list
+// endpoints in. Next and Previous are pointers so "you're on the first
+// page" is a nil check rather than an empty-string sentinel.
+type NamedAPIResourceList struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []NamedAPIResource `json:"results"`
+}
+
+// LocationArea is a single location-area, including the Pokémon known to
+// be encountered there.
+type LocationArea struct {
+	ID                int                `json:"id"`
+	Name              string             `json:"name"`
+	PokemonEncounters []PokemonEncounter `json:"pokemon_encounters"`
+}
+
+// PokemonEncounter is one entry in a LocationArea's pokemon_encounters list.
+type PokemonEncounter struct {
+	Pokemon NamedAPIResource `json:"pokemon"`
+}
+
+// LocationAreaEncounter is one entry in the list returned by a Pokémon's
+// location_area_encounters URL: the areas it can be found in.
+type LocationAreaEncounter struct {
+	LocationArea NamedAPIResource `json:"location_area"`
+}
+
+// Pokemon is a single Pokémon, as returned by the /pokemon/{name} endpoint.
+type Pokemon struct {
+	ID                     int           `json:"id"`
+	Name                   string        `json:"name"`
+	BaseExperience         int           `json:"base_experience"`
+	Height                 int           `json:"height"`
+	Weight                 int           `json:"weight"`
+	Stats                  []PokemonStat `json:"stats"`
+	Types                  []PokemonType `json:"types"`
+	LocationAreaEncounters string        `json:"location_area_encounters"`
+}
+
+// PokemonStat is one base stat entry (e.g. "hp", "attack") for a Pokémon.
+type PokemonStat struct {
+	BaseStat int              `json:"base_stat"`
+	Stat     NamedAPIResource `json:"stat"`
+}
+
+// PokemonType is one of a Pokémon's one or two elemental types.
+type PokemonType struct {
+	Slot int              `json:"slot"`
+	Type NamedAPIResource `json:"type"`
+}
@@ -0,0 +1,191 @@
+// Package pokecache is a concurrency-safe response cache keyed by URL,
+// bounded by entry count with LRU eviction and a per-entry TTL.
+package pokecache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTTL tells Add to use the cache's configured default TTL instead
+// of a per-entry override.
+const DefaultTTL time.Duration = 0
+
+// warmWorkers bounds how many URLs Warm fetches concurrently.
+const warmWorkers = 5
+
+type entry struct {
+	key       string
+	val       []byte
+	expiresAt time.Time
+}
+
+// Stats is a snapshot of a Cache's hit/miss/eviction counters.
+type Stats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+// Cache is an LRU cache with a per-entry TTL, backed by a doubly linked
+// list for recency order and a map for O(1) lookup.
+type Cache struct {
+	mu         sync.Mutex
+	maxEntries int
+	defaultTTL time.Duration
+	ll         *list.List
+	items      map[string]*list.Element
+	stats      Stats
+}
+
+// NewCache creates a Cache holding at most maxEntries items (0 means
+// unbounded), each expiring defaultTTL after being added unless Add is
+// given an explicit override.
+func NewCache(maxEntries int, defaultTTL time.Duration) *Cache {
+	return &Cache{
+		maxEntries: maxEntries,
+		defaultTTL: defaultTTL,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Add stores val under key, expiring it after ttl (or the cache's
+// DefaultTTL if ttl == DefaultTTL). If adding key pushes the cache over
+// its max entry count, the least recently used entry is evicted.
+func (c *Cache) Add(key string, val []byte, ttl time.Duration) {
+	if ttl == DefaultTTL {
+		ttl = c.defaultTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, found := c.items[key]; found {
+		c.ll.MoveToFront(el)
+		e := el.Value.(*entry)
+		e.val = val
+		e.expiresAt = time.Now().Add(ttl)
+		return
+	}
+
+	el := c.ll.PushFront(&entry{key: key, val: val, expiresAt: time.Now().Add(ttl)})
+	c.items[key] = el
+
+	if c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		c.evictOldest()
+	}
+}
+
+// Get returns the value stored under key, if present and unexpired.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, found := c.items[key]
+	if !found {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if time.Now().After(e.expiresAt) {
+		c.removeElement(el)
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	c.stats.Hits++
+	return e.val, true
+}
+
+// Stats returns a snapshot of the cache's hit/miss/eviction counters.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Purge removes every cached key with the given prefix (e.g. after a
+// schema change makes those responses stale) and returns how many
+// entries were removed.
+func (c *Cache) Purge(prefix string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(el)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Warm concurrently prefetches urls not already cached, using fetch to
+// retrieve each one and a bounded worker pool to limit concurrency. It
+// stops issuing new fetches once ctx is canceled and returns the first
+// fetch error encountered, if any.
+func (c *Cache) Warm(ctx context.Context, urls []string, fetch func(string) ([]byte, error)) error {
+	sem := make(chan struct{}, warmWorkers)
+	var wg sync.WaitGroup
+	errCh := make(chan error, 1)
+
+urls:
+	for _, url := range urls {
+		select {
+		case <-ctx.Done():
+			break urls
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(url string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if _, found := c.Get(url); found {
+				return
+			}
+
+			body, err := fetch(url)
+			if err != nil {
+				select {
+				case errCh <- fmt.Errorf("failed to warm %s: %v", url, err):
+				default:
+				}
+				return
+			}
+			c.Add(url, body, DefaultTTL)
+		}(url)
+	}
+
+	wg.Wait()
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
+
+func (c *Cache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*entry).key)
+}
+
+func (c *Cache) evictOldest() {
+	el := c.ll.Back()
+	if el == nil {
+		return
+	}
+	c.removeElement(el)
+	c.stats.Evictions++
+}
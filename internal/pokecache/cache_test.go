@@ -1,24 +1,125 @@
 package pokecache
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 )
 
-func TestCache(t *testing.T) {
-	cache := NewCache(2 * time.Second)
+func TestCacheAddGet(t *testing.T) {
+	cache := NewCache(10, time.Second)
 	key := "test-key"
 	val := []byte("test-value")
 
-	cache.Add(key, val)
+	cache.Add(key, val, DefaultTTL)
 
 	if result, found := cache.Get(key); !found || string(result) != "test-value" {
 		t.Fatalf("expected to find key %s with value %s", key, val)
 	}
+}
+
+func TestCacheEntryExpires(t *testing.T) {
+	cache := NewCache(10, 20*time.Millisecond)
+	cache.Add("test-key", []byte("test-value"), DefaultTTL)
+
+	time.Sleep(40 * time.Millisecond)
+
+	if _, found := cache.Get("test-key"); found {
+		t.Fatalf("expected key to have expired")
+	}
+}
+
+func TestCacheEntryOverridesTTL(t *testing.T) {
+	cache := NewCache(10, time.Hour)
+	cache.Add("test-key", []byte("test-value"), 20*time.Millisecond)
+
+	time.Sleep(40 * time.Millisecond)
+
+	if _, found := cache.Get("test-key"); found {
+		t.Fatalf("expected the per-entry TTL to override the cache default")
+	}
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewCache(2, time.Minute)
+	cache.Add("a", []byte("a"), DefaultTTL)
+	cache.Add("b", []byte("b"), DefaultTTL)
+
+	// Touch "a" so "b" becomes the least recently used entry.
+	cache.Get("a")
+	cache.Add("c", []byte("c"), DefaultTTL)
+
+	if _, found := cache.Get("b"); found {
+		t.Fatalf("expected \"b\" to have been evicted")
+	}
+	if _, found := cache.Get("a"); !found {
+		t.Fatalf("expected \"a\" to still be cached")
+	}
+
+	if stats := cache.Stats(); stats.Evictions != 1 {
+		t.Fatalf("expected 1 eviction, got %d", stats.Evictions)
+	}
+}
+
+func TestCachePurge(t *testing.T) {
+	cache := NewCache(10, time.Minute)
+	cache.Add("location-area/1", []byte("one"), DefaultTTL)
+	cache.Add("location-area/2", []byte("two"), DefaultTTL)
+	cache.Add("pokemon/1", []byte("bulbasaur"), DefaultTTL)
 
-	time.Sleep(3 * time.Second)
+	removed := cache.Purge("location-area/")
+	if removed != 2 {
+		t.Fatalf("expected 2 entries purged, got %d", removed)
+	}
+
+	if _, found := cache.Get("pokemon/1"); !found {
+		t.Fatalf("expected unrelated key to survive the purge")
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	cache := NewCache(10, time.Minute)
+	cache.Add("key", []byte("value"), DefaultTTL)
+
+	cache.Get("key")
+	cache.Get("missing")
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf("expected 1 hit and 1 miss, got %+v", stats)
+	}
+}
+
+func TestCacheWarm(t *testing.T) {
+	cache := NewCache(10, time.Minute)
+	urls := []string{"a", "b", "c"}
+
+	fetch := func(url string) ([]byte, error) {
+		return []byte(fmt.Sprintf("body-%s", url)), nil
+	}
+
+	if err := cache.Warm(context.Background(), urls, fetch); err != nil {
+		t.Fatalf("unexpected error warming cache: %v", err)
+	}
+
+	for _, url := range urls {
+		val, found := cache.Get(url)
+		if !found || string(val) != fmt.Sprintf("body-%s", url) {
+			t.Errorf("expected %q to be warmed, got %q (found=%v)", url, val, found)
+		}
+	}
+}
+
+func TestCacheWarmReturnsFetchError(t *testing.T) {
+	cache := NewCache(10, time.Minute)
+	fetchErr := fmt.Errorf("boom")
+
+	fetch := func(url string) ([]byte, error) {
+		return nil, fetchErr
+	}
 
-	if _, found := cache.Get(key); found {
-		t.Fatalf("expected key %s to be reaped from the cache", key)
+	if err := cache.Warm(context.Background(), []string{"a"}, fetch); err == nil {
+		t.Fatalf("expected an error from Warm")
 	}
 }
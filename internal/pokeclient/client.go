@@ -0,0 +1,141 @@
+// Package pokeclient provides a typed client for the subset of PokeAPI
+// this project talks to. It owns the HTTP client and the response cache,
+// so every command decodes JSON through the same path instead of each
+// rolling its own anonymous structs.
+package pokeclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/irukandji81/pokedex/internal/pokeapi"
+	"github.com/irukandji81/pokedex/internal/pokecache"
+)
+
+const baseURL = "https://pokeapi.co/api/v2"
+
+const defaultLocationAreaList = baseURL + "/location-area?limit=20"
+
+// Client fetches and decodes PokeAPI resources, caching raw responses by URL.
+type Client struct {
+	httpClient http.Client
+	cache      *pokecache.Cache
+}
+
+func NewClient(timeout time.Duration, cache *pokecache.Cache) *Client {
+	return &Client{
+		httpClient: http.Client{Timeout: timeout},
+		cache:      cache,
+	}
+}
+
+// get fetches the body at url, serving it from the cache when possible and
+// populating the cache on a miss.
+func (c *Client) get(url string) ([]byte, error) {
+	if val, found := c.cache.Get(url); found {
+		return val, nil
+	}
+
+	body, err := c.fetch(url)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.Add(url, body, pokecache.DefaultTTL)
+	return body, nil
+}
+
+// fetch performs the raw HTTP GET for url, bypassing the cache. It's
+// also the fetch function Warm uses to prefetch URLs.
+func (c *Client) fetch(url string) ([]byte, error) {
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %v", url, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	return body, nil
+}
+
+// WarmLocationAreaList prefetches the first page of the location-area
+// index into the cache, so the first "map" command is instant.
+func (c *Client) WarmLocationAreaList(ctx context.Context) error {
+	return c.cache.Warm(ctx, []string{defaultLocationAreaList}, c.fetch)
+}
+
+// GetNamedAPIResourceList fetches a page of the location-area index. An
+// empty url fetches the first page.
+func (c *Client) GetNamedAPIResourceList(url string) (pokeapi.NamedAPIResourceList, error) {
+	if url == "" {
+		url = defaultLocationAreaList
+	}
+
+	body, err := c.get(url)
+	if err != nil {
+		return pokeapi.NamedAPIResourceList{}, err
+	}
+
+	var list pokeapi.NamedAPIResourceList
+	if err := json.Unmarshal(body, &list); err != nil {
+		return pokeapi.NamedAPIResourceList{}, fmt.Errorf("failed to decode location area list: %v", err)
+	}
+	return list, nil
+}
+
+// GetLocationArea fetches a single location-area by name.
+func (c *Client) GetLocationArea(name string) (pokeapi.LocationArea, error) {
+	url := fmt.Sprintf("%s/location-area/%s", baseURL, name)
+	body, err := c.get(url)
+	if err != nil {
+		return pokeapi.LocationArea{}, err
+	}
+
+	var area pokeapi.LocationArea
+	if err := json.Unmarshal(body, &area); err != nil {
+		return pokeapi.LocationArea{}, fmt.Errorf("failed to decode location area %q: %v", name, err)
+	}
+	return area, nil
+}
+
+// GetPokemon fetches a single Pokémon by name.
+func (c *Client) GetPokemon(name string) (pokeapi.Pokemon, error) {
+	url := fmt.Sprintf("%s/pokemon/%s", baseURL, name)
+	body, err := c.get(url)
+	if err != nil {
+		return pokeapi.Pokemon{}, err
+	}
+
+	var pokemon pokeapi.Pokemon
+	if err := json.Unmarshal(body, &pokemon); err != nil {
+		return pokeapi.Pokemon{}, fmt.Errorf("failed to decode Pokémon %q: %v", name, err)
+	}
+	return pokemon, nil
+}
+
+// GetPokemonLocationAreas fetches the location areas a Pokémon can be
+// encountered in, given the URL from its LocationAreaEncounters field.
+func (c *Client) GetPokemonLocationAreas(path string) ([]pokeapi.LocationAreaEncounter, error) {
+	body, err := c.get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var encounters []pokeapi.LocationAreaEncounter
+	if err := json.Unmarshal(body, &encounters); err != nil {
+		return nil, fmt.Errorf("failed to decode location area encounters: %v", err)
+	}
+	return encounters, nil
+}
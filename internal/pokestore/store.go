@@ -0,0 +1,122 @@
+// Package pokestore persists a Trainer's Pokédex to disk. Saves are
+// written atomically and wrapped in a versioned envelope so the on-disk
+// shape of a Pokédex can evolve without breaking existing users' files.
+package pokestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+// CurrentSchemaVersion is the schema version this build writes, and the
+// version Load migrates saves up to.
+const CurrentSchemaVersion = 1
+
+// envelope is the on-disk wrapper around the Pokédex payload.
+type envelope struct {
+	SchemaVersion int                                `json:"schema_version"`
+	SavedAt       time.Time                          `json:"saved_at"`
+	Pokedex       map[string]poketrainer.PokemonData `json:"pokedex"`
+}
+
+// Migration upgrades a raw Pokédex payload from the schema version
+// immediately below toVersion up to toVersion.
+type Migration func(raw json.RawMessage) (json.RawMessage, error)
+
+// migrations maps a target schema version to the function that upgrades
+// a payload to it from the version directly below.
+var migrations = map[int]Migration{}
+
+func init() {
+	// Version 0 saves predate the envelope: the whole file is the bare
+	// {name: data} Pokédex map, so wrapping it needs no payload change.
+	RegisterMigration(1, func(raw json.RawMessage) (json.RawMessage, error) {
+		return raw, nil
+	})
+}
+
+// RegisterMigration registers the function that upgrades a payload from
+// toVersion-1 up to toVersion. Call it (typically from an init func)
+// whenever CurrentSchemaVersion is bumped.
+func RegisterMigration(toVersion int, migrate Migration) {
+	migrations[toVersion] = migrate
+}
+
+// Save writes the trainer's Pokédex to filename atomically: it writes to
+// a temporary file alongside filename, then renames it into place, so a
+// crash mid-write can never leave filename corrupted.
+func Save(trainer *poketrainer.Trainer, filename string) error {
+	env := envelope{
+		SchemaVersion: CurrentSchemaVersion,
+		SavedAt:       time.Now(),
+		Pokedex:       trainer.Pokedex,
+	}
+
+	prettyJSON, err := json.MarshalIndent(env, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to format Pokedex: %v", err)
+	}
+
+	tmpFile := filename + ".tmp"
+	if err := os.WriteFile(tmpFile, prettyJSON, 0644); err != nil {
+		return fmt.Errorf("failed to write temp file: %v", err)
+	}
+
+	if err := os.Rename(tmpFile, filename); err != nil {
+		return fmt.Errorf("failed to finalize save file: %v", err)
+	}
+	return nil
+}
+
+// Load reads filename into the trainer's Pokédex, running whatever
+// registered migrations are needed to bring an older save up to
+// CurrentSchemaVersion. A missing file is not an error.
+func Load(trainer *poketrainer.Trainer, filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			fmt.Println("No saved Pokedex found. Starting fresh!")
+			return nil
+		}
+		return fmt.Errorf("failed to open file: %v", err)
+	}
+
+	var onDisk struct {
+		SchemaVersion int             `json:"schema_version"`
+		Pokedex       json.RawMessage `json:"pokedex"`
+	}
+	if err := json.Unmarshal(data, &onDisk); err != nil {
+		return fmt.Errorf("failed to decode Pokedex: %v", err)
+	}
+
+	version := onDisk.SchemaVersion
+	payload := onDisk.Pokedex
+	if payload == nil {
+		// No "pokedex" key at all: this is a pre-envelope save, where the
+		// whole file is the bare Pokédex map.
+		version = 0
+		payload = data
+	}
+
+	for v := version + 1; v <= CurrentSchemaVersion; v++ {
+		migrate, found := migrations[v]
+		if !found {
+			return fmt.Errorf("no migration registered to reach schema version %d", v)
+		}
+		payload, err = migrate(payload)
+		if err != nil {
+			return fmt.Errorf("failed to migrate Pokedex to schema version %d: %v", v, err)
+		}
+	}
+
+	if err := json.Unmarshal(payload, &trainer.Pokedex); err != nil {
+		return fmt.Errorf("failed to decode Pokedex: %v", err)
+	}
+
+	fmt.Println("Pokedex loaded successfully!")
+	return nil
+}
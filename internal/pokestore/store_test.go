@@ -0,0 +1,80 @@
+package pokestore
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/irukandji81/pokedex/internal/poketrainer"
+)
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "pokedex.json")
+
+	trainer := poketrainer.NewTrainer()
+	trainer.Catch(poketrainer.PokemonData{
+		Name:   "pikachu",
+		Height: 4,
+		Weight: 60,
+		Stats:  map[string]int{"hp": 35},
+		Types:  []string{"electric"},
+	})
+
+	if err := Save(trainer, filename); err != nil {
+		t.Fatalf("Save returned an error: %v", err)
+	}
+
+	loaded := poketrainer.NewTrainer()
+	if err := Load(loaded, filename); err != nil {
+		t.Fatalf("Load returned an error: %v", err)
+	}
+
+	if !loaded.HasCaught("pikachu") {
+		t.Fatalf("expected pikachu to survive a save/load round trip")
+	}
+}
+
+func TestSaveDoesNotLeaveTempFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "pokedex.json")
+
+	if err := Save(poketrainer.NewTrainer(), filename); err != nil {
+		t.Fatalf("Save returned an error: %v", err)
+	}
+
+	if _, err := os.Stat(filename + ".tmp"); !os.IsNotExist(err) {
+		t.Fatalf("expected the temp file to be renamed away, stat err: %v", err)
+	}
+}
+
+func TestLoadMigratesPreEnvelopeSave(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "pokedex.json")
+
+	bare := map[string]poketrainer.PokemonData{
+		"bulbasaur": {Name: "bulbasaur", Height: 7, Weight: 69},
+	}
+	data, err := json.Marshal(bare)
+	if err != nil {
+		t.Fatalf("failed to marshal fixture: %v", err)
+	}
+	if err := os.WriteFile(filename, data, 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	trainer := poketrainer.NewTrainer()
+	if err := Load(trainer, filename); err != nil {
+		t.Fatalf("Load returned an error: %v", err)
+	}
+
+	if !trainer.HasCaught("bulbasaur") {
+		t.Fatalf("expected a pre-envelope save to still load")
+	}
+}
+
+func TestLoadMissingFileIsNotAnError(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "does-not-exist.json")
+
+	if err := Load(poketrainer.NewTrainer(), filename); err != nil {
+		t.Fatalf("expected a missing file to load without error, got: %v", err)
+	}
+}
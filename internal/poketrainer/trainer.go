@@ -0,0 +1,71 @@
+// Package poketrainer holds the state that travels with the player: the
+// Pokédex they've filled in, where they currently are, and the pagination
+// cursors for browsing location areas. Keeping it here (instead of in the
+// REPL's config) lets commands depend on just the pieces of state they
+// actually need.
+package poketrainer
+
+// PokemonData is a caught Pokémon's record in the Trainer's Pokédex.
+type PokemonData struct {
+	Name   string
+	Height int
+	Weight int
+	Stats  map[string]int
+	Types  []string
+}
+
+// Trainer is the player: their Pokédex, their current location, and the
+// pagination cursors for the location-area list.
+type Trainer struct {
+	Pokedex  map[string]PokemonData
+	Current  string
+	Next     *string
+	Previous *string
+
+	// KnownAreas is every location area the trainer has visited or
+	// paged past, in the order first seen. It exists so the REPL can
+	// offer tab-completion for "visit" without re-fetching anything.
+	KnownAreas []string
+
+	// KnownPokemon is the Pokémon encounter list from the last area
+	// explored, kept around for "catch" tab-completion.
+	KnownPokemon []string
+}
+
+func NewTrainer() *Trainer {
+	return &Trainer{
+		Pokedex: make(map[string]PokemonData),
+	}
+}
+
+// HasCaught reports whether name is already in the Pokédex.
+func (t *Trainer) HasCaught(name string) bool {
+	_, found := t.Pokedex[name]
+	return found
+}
+
+// Catch records a caught Pokémon in the Pokédex.
+func (t *Trainer) Catch(data PokemonData) {
+	t.Pokedex[data.Name] = data
+}
+
+// Visit sets the trainer's current location area.
+func (t *Trainer) Visit(area string) {
+	t.Current = area
+}
+
+// RememberArea records area as known, for tab-completion, if it isn't already.
+func (t *Trainer) RememberArea(area string) {
+	for _, known := range t.KnownAreas {
+		if known == area {
+			return
+		}
+	}
+	t.KnownAreas = append(t.KnownAreas, area)
+}
+
+// RememberPokemon replaces the Pokémon known to be in the current area,
+// for tab-completion.
+func (t *Trainer) RememberPokemon(names []string) {
+	t.KnownPokemon = names
+}
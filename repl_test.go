@@ -15,6 +15,10 @@ func TestCleanInput(t *testing.T) {
 			input:    "Charmander Bulbasaur PIKACHU",
 			expected: []string{"charmander", "bulbasaur", "pikachu"},
 		},
+		{
+			input:    `visit "mt moon 1f"`,
+			expected: []string{"visit", "mt moon 1f"},
+		},
 	}
 
 	for _, c := range cases {